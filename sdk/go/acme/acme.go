@@ -0,0 +1,196 @@
+// Package acme implements a github.com/go-acme/lego challenge.Provider backed
+// by the Atlas DNS API client, so lego-based tooling (Traefik, cert-manager's
+// ACME webhook shims, certbot-via-lego, etc.) can complete DNS-01 challenges
+// against Atlas DNS.
+package acme
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/platform/config/env"
+
+	atlasdns "github.com/ktheindifferent/AtlasDNS/sdk/go"
+)
+
+// defaultPropagationTimeout and defaultPollingInterval are the defaults lego
+// uses to poll for DNS propagation before giving up on a challenge.
+const (
+	defaultPropagationTimeout = 60 * time.Second
+	defaultPollingInterval    = 2 * time.Second
+	defaultTTL                = 120
+)
+
+// Config configures a DNSProvider. Fields are populated from environment
+// variables by NewDefaultConfig, following the convention used by the rest
+// of the lego provider ecosystem.
+type Config struct {
+	APIKey             string
+	BaseURL            string
+	TTL                int
+	PropagationTimeout time.Duration
+	PollingInterval    time.Duration
+}
+
+// NewDefaultConfig returns a Config populated from the ATLAS_DNS_API_KEY and
+// ATLAS_DNS_BASE_URL environment variables, with lego's usual propagation
+// defaults.
+func NewDefaultConfig() *Config {
+	return &Config{
+		APIKey:             env.GetOrDefaultString("ATLAS_DNS_API_KEY", ""),
+		BaseURL:            env.GetOrDefaultString("ATLAS_DNS_BASE_URL", ""),
+		TTL:                env.GetOrDefaultInt("ATLAS_DNS_TTL", defaultTTL),
+		PropagationTimeout: env.GetOrDefaultSecond("ATLAS_DNS_PROPAGATION_TIMEOUT", defaultPropagationTimeout),
+		PollingInterval:    env.GetOrDefaultSecond("ATLAS_DNS_POLLING_INTERVAL", defaultPollingInterval),
+	}
+}
+
+// challengeRecord tracks a record created for an in-flight challenge so
+// CleanUp can remove exactly the record Present created.
+type challengeRecord struct {
+	zoneID   string
+	recordID string
+}
+
+// DNSProvider implements challenge.Provider and challenge.ProviderTimeout on
+// top of an Atlas DNS Client.
+type DNSProvider struct {
+	config *Config
+	client *atlasdns.Client
+
+	mu      sync.Mutex
+	records map[string]challengeRecord // keyed by fqdn+token
+}
+
+var (
+	_ challenge.Provider        = (*DNSProvider)(nil)
+	_ challenge.ProviderTimeout = (*DNSProvider)(nil)
+)
+
+// NewDNSProvider returns a DNSProvider configured from the environment. Use
+// NewDNSProviderConfig if the Atlas DNS credentials come from somewhere else.
+func NewDNSProvider() (*DNSProvider, error) {
+	return NewDNSProviderConfig(NewDefaultConfig())
+}
+
+// NewDNSProviderConfig returns a DNSProvider using the given Config.
+func NewDNSProviderConfig(config *Config) (*DNSProvider, error) {
+	if config == nil {
+		return nil, fmt.Errorf("atlasdns: the configuration of the DNS provider is nil")
+	}
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("atlasdns: API key is missing")
+	}
+	if config.BaseURL == "" {
+		return nil, fmt.Errorf("atlasdns: base URL is missing")
+	}
+
+	return &DNSProvider{
+		config:  config,
+		client:  atlasdns.NewClient(config.BaseURL, config.APIKey),
+		records: make(map[string]challengeRecord),
+	}, nil
+}
+
+// Timeout returns the timeout and interval lego should use when polling for
+// DNS propagation of a challenge record.
+func (d *DNSProvider) Timeout() (timeout, interval time.Duration) {
+	return d.config.PropagationTimeout, d.config.PollingInterval
+}
+
+// Present creates a TXT record for the DNS-01 challenge under
+// _acme-challenge.<domain>, containing the base64url SHA-256 digest of
+// keyAuth as required by RFC 8555 section 8.4.
+func (d *DNSProvider) Present(domain, token, keyAuth string) error {
+	fqdn, value := challengeFQDNAndValue(domain, keyAuth)
+
+	zone, err := d.findZone(fqdn)
+	if err != nil {
+		return fmt.Errorf("atlasdns: could not find zone for domain %q: %w", domain, err)
+	}
+
+	record, err := d.client.CreateRecord(context.Background(), zone.ID, &atlasdns.Record{
+		Name:  fqdn,
+		Type:  "TXT",
+		Value: value,
+		TTL:   d.config.TTL,
+	})
+	if err != nil {
+		return fmt.Errorf("atlasdns: failed to create TXT record: %w", err)
+	}
+
+	d.mu.Lock()
+	d.records[recordKey(fqdn, token)] = challengeRecord{zoneID: zone.ID, recordID: record.ID}
+	d.mu.Unlock()
+
+	return nil
+}
+
+// CleanUp removes the TXT record created by Present for this domain/token.
+func (d *DNSProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn, _ := challengeFQDNAndValue(domain, keyAuth)
+	key := recordKey(fqdn, token)
+
+	d.mu.Lock()
+	record, ok := d.records[key]
+	delete(d.records, key)
+	d.mu.Unlock()
+
+	if !ok {
+		// Nothing to do: Present either failed before creating the record,
+		// or CleanUp already ran.
+		return nil
+	}
+
+	if err := d.client.DeleteRecord(context.Background(), record.zoneID, record.recordID); err != nil {
+		return fmt.Errorf("atlasdns: failed to delete TXT record: %w", err)
+	}
+	return nil
+}
+
+// findZone finds the zone that should hold fqdn by listing zones and
+// picking the longest matching suffix, mirroring the zone-resolution
+// strategy used by other lego DNS providers that lack a dedicated
+// "find zone for domain" API.
+func (d *DNSProvider) findZone(fqdn string) (*atlasdns.Zone, error) {
+	zones, err := d.client.ListZones(context.Background(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	fqdn = strings.TrimSuffix(fqdn, ".")
+
+	var best *atlasdns.Zone
+	for i := range zones {
+		name := strings.TrimSuffix(zones[i].Name, ".")
+		if fqdn == name || strings.HasSuffix(fqdn, "."+name) {
+			if best == nil || len(name) > len(strings.TrimSuffix(best.Name, ".")) {
+				best = &zones[i]
+			}
+		}
+	}
+
+	if best == nil {
+		return nil, fmt.Errorf("no zone found for %q", fqdn)
+	}
+	return best, nil
+}
+
+// challengeFQDNAndValue computes the _acme-challenge FQDN and the TXT
+// record value for a DNS-01 challenge per RFC 8555 section 8.4.
+func challengeFQDNAndValue(domain, keyAuth string) (fqdn, value string) {
+	digest := sha256.Sum256([]byte(keyAuth))
+	value = base64.RawURLEncoding.EncodeToString(digest[:])
+	fqdn = fmt.Sprintf("_acme-challenge.%s.", strings.TrimSuffix(domain, "."))
+	return fqdn, value
+}
+
+func recordKey(fqdn, token string) string {
+	return fqdn + "|" + token
+}
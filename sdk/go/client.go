@@ -4,11 +4,15 @@ package atlasdns
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/time/rate"
 )
 
@@ -19,6 +23,14 @@ type Client struct {
 	httpClient  *resty.Client
 	rateLimiter *rate.Limiter
 	debug       bool
+
+	tracerProvider         trace.TracerProvider
+	meterProvider          metric.MeterProvider
+	logger                 *slog.Logger
+	tracer                 trace.Tracer
+	requestDuration        metric.Float64Histogram
+	rateLimitedCounter     metric.Int64Counter
+	rateLimitWaitThreshold time.Duration
 }
 
 // ClientOption is a function that configures a Client
@@ -27,11 +39,15 @@ type ClientOption func(*Client)
 // NewClient creates a new Atlas DNS client
 func NewClient(baseURL string, apiKey string, opts ...ClientOption) *Client {
 	c := &Client{
-		baseURL:     baseURL,
-		apiKey:      apiKey,
-		httpClient:  resty.New(),
-		rateLimiter: rate.NewLimiter(rate.Every(time.Second/10), 10), // 10 requests per second
-		debug:       false,
+		baseURL:                baseURL,
+		apiKey:                 apiKey,
+		httpClient:             resty.New(),
+		rateLimiter:            rate.NewLimiter(rate.Every(time.Second/10), 10), // 10 requests per second
+		debug:                  false,
+		tracerProvider:         trace.NewNoopTracerProvider(),
+		meterProvider:          noopMeterProvider(),
+		logger:                 slog.Default(),
+		rateLimitWaitThreshold: defaultRateLimitWaitThreshold,
 	}
 
 	// Set default timeout
@@ -43,7 +59,7 @@ func NewClient(baseURL string, apiKey string, opts ...ClientOption) *Client {
 	// Set headers
 	c.httpClient.SetHeader("Content-Type", "application/json")
 	c.httpClient.SetHeader("User-Agent", "atlas-dns-go-sdk/1.0.0")
-	
+
 	if apiKey != "" {
 		c.httpClient.SetHeader("X-API-Key", apiKey)
 	}
@@ -53,6 +69,8 @@ func NewClient(baseURL string, apiKey string, opts ...ClientOption) *Client {
 		opt(c)
 	}
 
+	c.initInstrumentation()
+
 	c.httpClient.SetBaseURL(baseURL + "/api/v2")
 	c.httpClient.SetDebug(c.debug)
 
@@ -85,6 +103,31 @@ func WithDebug(debug bool) ClientOption {
 	}
 }
 
+// WithTracerProvider sets the OpenTelemetry TracerProvider used to create a
+// span for every API call. Defaults to a no-op provider.
+func WithTracerProvider(tp trace.TracerProvider) ClientOption {
+	return func(c *Client) {
+		c.tracerProvider = tp
+	}
+}
+
+// WithMeterProvider sets the OpenTelemetry MeterProvider used to record the
+// atlasdns.client.request.duration histogram and the
+// atlasdns.client.rate_limited counter. Defaults to a no-op provider.
+func WithMeterProvider(mp metric.MeterProvider) ClientOption {
+	return func(c *Client) {
+		c.meterProvider = mp
+	}
+}
+
+// WithLogger sets the structured logger used for per-request logging (debug
+// level on success, error level on failure). Defaults to slog.Default().
+func WithLogger(logger *slog.Logger) ClientOption {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
@@ -93,18 +136,55 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
-// doRequest performs an API request with rate limiting and context support
-func (c *Client) doRequest(ctx context.Context, req *resty.Request) (*resty.Response, error) {
-	// Apply rate limiting
+// doRequest performs an API request with rate limiting, context support,
+// and tracing/metrics/logging instrumentation. operation is the SDK method
+// name (e.g. "ListZones") and route is the templated path (e.g.
+// "/zones/{zoneID}") used for span/metric attributes so cardinality stays
+// bounded regardless of how many zones or records exist. zoneID and
+// recordID are added as span attributes when the call operates on a
+// specific zone or record, and left empty otherwise (e.g. ListZones).
+func (c *Client) doRequest(ctx context.Context, operation, route, zoneID, recordID string, req *resty.Request) (*resty.Response, error) {
+	// Apply rate limiting, tracking how long we were blocked so sustained
+	// throttling shows up in atlasdns.client.rate_limited.
+	waitStart := time.Now()
 	if err := c.rateLimiter.Wait(ctx); err != nil {
 		return nil, fmt.Errorf("rate limiter error: %w", err)
 	}
+	c.recordRateLimitWait(ctx, route, time.Since(waitStart))
+
+	requestID := req.Header.Get(headerRequestID)
+	if requestID == "" {
+		requestID = newRequestID()
+		req.SetHeader(headerRequestID, requestID)
+	}
+
+	ctx, span := c.startSpan(ctx, operation, route, req.Method, requestID, zoneID, recordID)
+	defer span.end()
 
-	// Set context
 	req.SetContext(ctx)
 
-	// Execute request
-	return req.Execute(req.Method, req.URL)
+	start := time.Now()
+	resp, err := req.Execute(req.Method, req.URL)
+	duration := time.Since(start)
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode()
+	}
+	span.setStatusCode(statusCode)
+
+	if err != nil {
+		span.recordError(err)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			apiErr.RequestID = requestID
+		}
+	}
+
+	c.recordRequestDuration(ctx, route, statusCode, duration)
+	c.logRequest(ctx, operation, route, requestID, statusCode, duration, err)
+
+	return resp, err
 }
 
 // Zone Management
@@ -112,66 +192,66 @@ func (c *Client) doRequest(ctx context.Context, req *resty.Request) (*resty.Resp
 // ListZones returns all DNS zones
 func (c *Client) ListZones(ctx context.Context, params *ListParams) ([]Zone, error) {
 	var zones []Zone
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&zones)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/zones"))
+
+	_, err := c.doRequest(ctx, "ListZones", "/zones", "", "", req.SetContext(ctx).Get("/zones"))
 	return zones, err
 }
 
 // GetZone retrieves a specific zone
 func (c *Client) GetZone(ctx context.Context, zoneID string) (*Zone, error) {
 	var zone Zone
-	
+
 	req := c.httpClient.R().
 		SetResult(&zone)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s", zoneID)))
+
+	_, err := c.doRequest(ctx, "GetZone", "/zones/{zoneID}", zoneID, "", req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s", zoneID)))
 	return &zone, err
 }
 
 // CreateZone creates a new DNS zone
 func (c *Client) CreateZone(ctx context.Context, zone *Zone) (*Zone, error) {
 	var result Zone
-	
+
 	req := c.httpClient.R().
 		SetBody(zone).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/zones"))
+
+	_, err := c.doRequest(ctx, "CreateZone", "/zones", "", "", req.SetContext(ctx).Post("/zones"))
 	return &result, err
 }
 
 // UpdateZone updates an existing zone
 func (c *Client) UpdateZone(ctx context.Context, zoneID string, updates *ZoneUpdate) (*Zone, error) {
 	var zone Zone
-	
+
 	req := c.httpClient.R().
 		SetBody(updates).
 		SetResult(&zone)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Put(fmt.Sprintf("/zones/%s", zoneID)))
+
+	_, err := c.doRequest(ctx, "UpdateZone", "/zones/{zoneID}", zoneID, "", req.SetContext(ctx).Put(fmt.Sprintf("/zones/%s", zoneID)))
 	return &zone, err
 }
 
 // DeleteZone deletes a zone
 func (c *Client) DeleteZone(ctx context.Context, zoneID string) error {
 	req := c.httpClient.R()
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Delete(fmt.Sprintf("/zones/%s", zoneID)))
+
+	_, err := c.doRequest(ctx, "DeleteZone", "/zones/{zoneID}", zoneID, "", req.SetContext(ctx).Delete(fmt.Sprintf("/zones/%s", zoneID)))
 	return err
 }
 
 // ValidateZone validates zone configuration
 func (c *Client) ValidateZone(ctx context.Context, zoneID string) (*ValidationResult, error) {
 	var result ValidationResult
-	
+
 	req := c.httpClient.R().
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/validate", zoneID)))
+
+	_, err := c.doRequest(ctx, "ValidateZone", "/zones/{zoneID}/validate", zoneID, "", req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/validate", zoneID)))
 	return &result, err
 }
 
@@ -180,67 +260,67 @@ func (c *Client) ValidateZone(ctx context.Context, zoneID string) (*ValidationRe
 // ListRecords returns all records in a zone
 func (c *Client) ListRecords(ctx context.Context, zoneID string, params *ListParams) ([]Record, error) {
 	var records []Record
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&records)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/records", zoneID)))
+
+	_, err := c.doRequest(ctx, "ListRecords", "/zones/{zoneID}/records", zoneID, "", req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/records", zoneID)))
 	return records, err
 }
 
 // GetRecord retrieves a specific record
 func (c *Client) GetRecord(ctx context.Context, zoneID, recordID string) (*Record, error) {
 	var record Record
-	
+
 	req := c.httpClient.R().
 		SetResult(&record)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
+
+	_, err := c.doRequest(ctx, "GetRecord", "/zones/{zoneID}/records/{recordID}", zoneID, recordID, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
 	return &record, err
 }
 
 // CreateRecord creates a new DNS record
 func (c *Client) CreateRecord(ctx context.Context, zoneID string, record *Record) (*Record, error) {
 	var result Record
-	
+
 	req := c.httpClient.R().
 		SetBody(record).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/records", zoneID)))
+
+	_, err := c.doRequest(ctx, "CreateRecord", "/zones/{zoneID}/records", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/records", zoneID)))
 	return &result, err
 }
 
 // UpdateRecord updates an existing record
 func (c *Client) UpdateRecord(ctx context.Context, zoneID, recordID string, updates *RecordUpdate) (*Record, error) {
 	var record Record
-	
+
 	req := c.httpClient.R().
 		SetBody(updates).
 		SetResult(&record)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Put(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
+
+	_, err := c.doRequest(ctx, "UpdateRecord", "/zones/{zoneID}/records/{recordID}", zoneID, recordID, req.SetContext(ctx).Put(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
 	return &record, err
 }
 
 // DeleteRecord deletes a record
 func (c *Client) DeleteRecord(ctx context.Context, zoneID, recordID string) error {
 	req := c.httpClient.R()
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Delete(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
+
+	_, err := c.doRequest(ctx, "DeleteRecord", "/zones/{zoneID}/records/{recordID}", zoneID, recordID, req.SetContext(ctx).Delete(fmt.Sprintf("/zones/%s/records/%s", zoneID, recordID)))
 	return err
 }
 
 // BulkCreateRecords creates multiple records at once
 func (c *Client) BulkCreateRecords(ctx context.Context, zoneID string, records []Record) ([]Record, error) {
 	var result []Record
-	
+
 	req := c.httpClient.R().
 		SetBody(map[string]interface{}{"records": records}).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/records/bulk", zoneID)))
+
+	_, err := c.doRequest(ctx, "BulkCreateRecords", "/zones/{zoneID}/records/bulk", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/records/bulk", zoneID)))
 	return result, err
 }
 
@@ -249,66 +329,66 @@ func (c *Client) BulkCreateRecords(ctx context.Context, zoneID string, records [
 // ListHealthChecks returns all health checks
 func (c *Client) ListHealthChecks(ctx context.Context, params *ListParams) ([]HealthCheck, error) {
 	var checks []HealthCheck
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&checks)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/health-checks"))
+
+	_, err := c.doRequest(ctx, "ListHealthChecks", "/health-checks", "", "", req.SetContext(ctx).Get("/health-checks"))
 	return checks, err
 }
 
 // GetHealthCheck retrieves a specific health check
 func (c *Client) GetHealthCheck(ctx context.Context, checkID string) (*HealthCheck, error) {
 	var check HealthCheck
-	
+
 	req := c.httpClient.R().
 		SetResult(&check)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/health-checks/%s", checkID)))
+
+	_, err := c.doRequest(ctx, "GetHealthCheck", "/health-checks/{checkID}", "", "", req.SetContext(ctx).Get(fmt.Sprintf("/health-checks/%s", checkID)))
 	return &check, err
 }
 
 // CreateHealthCheck creates a new health check
 func (c *Client) CreateHealthCheck(ctx context.Context, check *HealthCheck) (*HealthCheck, error) {
 	var result HealthCheck
-	
+
 	req := c.httpClient.R().
 		SetBody(check).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/health-checks"))
+
+	_, err := c.doRequest(ctx, "CreateHealthCheck", "/health-checks", "", "", req.SetContext(ctx).Post("/health-checks"))
 	return &result, err
 }
 
 // UpdateHealthCheck updates a health check
 func (c *Client) UpdateHealthCheck(ctx context.Context, checkID string, updates *HealthCheckUpdate) (*HealthCheck, error) {
 	var check HealthCheck
-	
+
 	req := c.httpClient.R().
 		SetBody(updates).
 		SetResult(&check)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Put(fmt.Sprintf("/health-checks/%s", checkID)))
+
+	_, err := c.doRequest(ctx, "UpdateHealthCheck", "/health-checks/{checkID}", "", "", req.SetContext(ctx).Put(fmt.Sprintf("/health-checks/%s", checkID)))
 	return &check, err
 }
 
 // DeleteHealthCheck deletes a health check
 func (c *Client) DeleteHealthCheck(ctx context.Context, checkID string) error {
 	req := c.httpClient.R()
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Delete(fmt.Sprintf("/health-checks/%s", checkID)))
+
+	_, err := c.doRequest(ctx, "DeleteHealthCheck", "/health-checks/{checkID}", "", "", req.SetContext(ctx).Delete(fmt.Sprintf("/health-checks/%s", checkID)))
 	return err
 }
 
 // TestHealthCheck runs a health check test
 func (c *Client) TestHealthCheck(ctx context.Context, checkID string) (*HealthCheckResult, error) {
 	var result HealthCheckResult
-	
+
 	req := c.httpClient.R().
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/health-checks/%s/test", checkID)))
+
+	_, err := c.doRequest(ctx, "TestHealthCheck", "/health-checks/{checkID}/test", "", "", req.SetContext(ctx).Post(fmt.Sprintf("/health-checks/%s/test", checkID)))
 	return &result, err
 }
 
@@ -317,47 +397,47 @@ func (c *Client) TestHealthCheck(ctx context.Context, checkID string) (*HealthCh
 // ListTrafficPolicies returns all traffic policies
 func (c *Client) ListTrafficPolicies(ctx context.Context, params *ListParams) ([]TrafficPolicy, error) {
 	var policies []TrafficPolicy
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&policies)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/traffic-policies"))
+
+	_, err := c.doRequest(ctx, "ListTrafficPolicies", "/traffic-policies", "", "", req.SetContext(ctx).Get("/traffic-policies"))
 	return policies, err
 }
 
 // GetTrafficPolicy retrieves a specific traffic policy
 func (c *Client) GetTrafficPolicy(ctx context.Context, policyID string) (*TrafficPolicy, error) {
 	var policy TrafficPolicy
-	
+
 	req := c.httpClient.R().
 		SetResult(&policy)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/traffic-policies/%s", policyID)))
+
+	_, err := c.doRequest(ctx, "GetTrafficPolicy", "/traffic-policies/{policyID}", "", "", req.SetContext(ctx).Get(fmt.Sprintf("/traffic-policies/%s", policyID)))
 	return &policy, err
 }
 
 // CreateTrafficPolicy creates a new traffic policy
 func (c *Client) CreateTrafficPolicy(ctx context.Context, policy *TrafficPolicy) (*TrafficPolicy, error) {
 	var result TrafficPolicy
-	
+
 	req := c.httpClient.R().
 		SetBody(policy).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/traffic-policies"))
+
+	_, err := c.doRequest(ctx, "CreateTrafficPolicy", "/traffic-policies", "", "", req.SetContext(ctx).Post("/traffic-policies"))
 	return &result, err
 }
 
 // SimulateTrafficPolicy simulates a traffic policy
 func (c *Client) SimulateTrafficPolicy(ctx context.Context, policyID string, params *SimulationParams) (*SimulationResult, error) {
 	var result SimulationResult
-	
+
 	req := c.httpClient.R().
 		SetBody(params).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/traffic-policies/%s/simulate", policyID)))
+
+	_, err := c.doRequest(ctx, "SimulateTrafficPolicy", "/traffic-policies/{policyID}/simulate", "", "", req.SetContext(ctx).Post(fmt.Sprintf("/traffic-policies/%s/simulate", policyID)))
 	return &result, err
 }
 
@@ -366,46 +446,46 @@ func (c *Client) SimulateTrafficPolicy(ctx context.Context, policyID string, par
 // ListGeoDNSRules returns all GeoDNS rules
 func (c *Client) ListGeoDNSRules(ctx context.Context, params *ListParams) ([]GeoDNSRule, error) {
 	var rules []GeoDNSRule
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&rules)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/geodns"))
+
+	_, err := c.doRequest(ctx, "ListGeoDNSRules", "/geodns", "", "", req.SetContext(ctx).Get("/geodns"))
 	return rules, err
 }
 
 // GetGeoDNSRule retrieves a specific GeoDNS rule
 func (c *Client) GetGeoDNSRule(ctx context.Context, ruleID string) (*GeoDNSRule, error) {
 	var rule GeoDNSRule
-	
+
 	req := c.httpClient.R().
 		SetResult(&rule)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/geodns/%s", ruleID)))
+
+	_, err := c.doRequest(ctx, "GetGeoDNSRule", "/geodns/{ruleID}", "", "", req.SetContext(ctx).Get(fmt.Sprintf("/geodns/%s", ruleID)))
 	return &rule, err
 }
 
 // CreateGeoDNSRule creates a new GeoDNS rule
 func (c *Client) CreateGeoDNSRule(ctx context.Context, rule *GeoDNSRule) (*GeoDNSRule, error) {
 	var result GeoDNSRule
-	
+
 	req := c.httpClient.R().
 		SetBody(rule).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/geodns"))
+
+	_, err := c.doRequest(ctx, "CreateGeoDNSRule", "/geodns", "", "", req.SetContext(ctx).Post("/geodns"))
 	return &result, err
 }
 
 // GetGeoDNSRegions returns available GeoDNS regions
 func (c *Client) GetGeoDNSRegions(ctx context.Context) ([]Region, error) {
 	var regions []Region
-	
+
 	req := c.httpClient.R().
 		SetResult(&regions)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/geodns/regions"))
+
+	_, err := c.doRequest(ctx, "GetGeoDNSRegions", "/geodns/regions", "", "", req.SetContext(ctx).Get("/geodns/regions"))
 	return regions, err
 }
 
@@ -414,42 +494,42 @@ func (c *Client) GetGeoDNSRegions(ctx context.Context) ([]Region, error) {
 // GetDNSSECStatus retrieves DNSSEC status for a zone
 func (c *Client) GetDNSSECStatus(ctx context.Context, zoneID string) (*DNSSECConfig, error) {
 	var config DNSSECConfig
-	
+
 	req := c.httpClient.R().
 		SetResult(&config)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/dnssec", zoneID)))
+
+	_, err := c.doRequest(ctx, "GetDNSSECStatus", "/zones/{zoneID}/dnssec", zoneID, "", req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/dnssec", zoneID)))
 	return &config, err
 }
 
 // EnableDNSSEC enables DNSSEC for a zone
 func (c *Client) EnableDNSSEC(ctx context.Context, zoneID string, config *DNSSECConfig) (*DNSSECConfig, error) {
 	var result DNSSECConfig
-	
+
 	req := c.httpClient.R().
 		SetBody(config).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/enable", zoneID)))
+
+	_, err := c.doRequest(ctx, "EnableDNSSEC", "/zones/{zoneID}/dnssec/enable", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/enable", zoneID)))
 	return &result, err
 }
 
 // DisableDNSSEC disables DNSSEC for a zone
 func (c *Client) DisableDNSSEC(ctx context.Context, zoneID string) error {
 	req := c.httpClient.R()
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/disable", zoneID)))
+
+	_, err := c.doRequest(ctx, "DisableDNSSEC", "/zones/{zoneID}/dnssec/disable", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/disable", zoneID)))
 	return err
 }
 
 // RotateDNSSECKeys rotates DNSSEC keys for a zone
 func (c *Client) RotateDNSSECKeys(ctx context.Context, zoneID string) (*DNSSECConfig, error) {
 	var config DNSSECConfig
-	
+
 	req := c.httpClient.R().
 		SetResult(&config)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/rotate-keys", zoneID)))
+
+	_, err := c.doRequest(ctx, "RotateDNSSECKeys", "/zones/{zoneID}/dnssec/rotate-keys", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/rotate-keys", zoneID)))
 	return &config, err
 }
 
@@ -458,36 +538,36 @@ func (c *Client) RotateDNSSECKeys(ctx context.Context, zoneID string) (*DNSSECCo
 // GetAnalyticsOverview retrieves analytics overview
 func (c *Client) GetAnalyticsOverview(ctx context.Context, params *AnalyticsParams) (*AnalyticsData, error) {
 	var data AnalyticsData
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&data)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/analytics/overview"))
+
+	_, err := c.doRequest(ctx, "GetAnalyticsOverview", "/analytics/overview", "", "", req.SetContext(ctx).Get("/analytics/overview"))
 	return &data, err
 }
 
 // GetQueryAnalytics retrieves query analytics
 func (c *Client) GetQueryAnalytics(ctx context.Context, params *AnalyticsParams) (*QueryAnalytics, error) {
 	var analytics QueryAnalytics
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&analytics)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/analytics/queries"))
+
+	_, err := c.doRequest(ctx, "GetQueryAnalytics", "/analytics/queries", "", "", req.SetContext(ctx).Get("/analytics/queries"))
 	return &analytics, err
 }
 
 // GetTopDomains retrieves top queried domains
 func (c *Client) GetTopDomains(ctx context.Context, params *TopDomainsParams) ([]DomainStats, error) {
 	var domains []DomainStats
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&domains)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/analytics/top-domains"))
+
+	_, err := c.doRequest(ctx, "GetTopDomains", "/analytics/top-domains", "", "", req.SetContext(ctx).Get("/analytics/top-domains"))
 	return domains, err
 }
 
@@ -496,12 +576,12 @@ func (c *Client) GetTopDomains(ctx context.Context, params *TopDomainsParams) ([
 // QueryDNS performs a DNS query
 func (c *Client) QueryDNS(ctx context.Context, query *DNSQuery) (*QueryResult, error) {
 	var result QueryResult
-	
+
 	req := c.httpClient.R().
 		SetBody(query).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/query"))
+
+	_, err := c.doRequest(ctx, "QueryDNS", "/query", "", "", req.SetContext(ctx).Post("/query"))
 	return &result, err
 }
 
@@ -510,23 +590,23 @@ func (c *Client) QueryDNS(ctx context.Context, query *DNSQuery) (*QueryResult, e
 // GetSystemStatus retrieves system status
 func (c *Client) GetSystemStatus(ctx context.Context) (*SystemStatus, error) {
 	var status SystemStatus
-	
+
 	req := c.httpClient.R().
 		SetResult(&status)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/monitoring/status"))
+
+	_, err := c.doRequest(ctx, "GetSystemStatus", "/monitoring/status", "", "", req.SetContext(ctx).Get("/monitoring/status"))
 	return &status, err
 }
 
 // GetMetrics retrieves system metrics
 func (c *Client) GetMetrics(ctx context.Context, params *MetricsParams) (*Metrics, error) {
 	var metrics Metrics
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&metrics)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/monitoring/metrics"))
+
+	_, err := c.doRequest(ctx, "GetMetrics", "/monitoring/metrics", "", "", req.SetContext(ctx).Get("/monitoring/metrics"))
 	return &metrics, err
 }
 
@@ -535,46 +615,46 @@ func (c *Client) GetMetrics(ctx context.Context, params *MetricsParams) (*Metric
 // ListWebhooks returns all webhook endpoints
 func (c *Client) ListWebhooks(ctx context.Context, params *ListParams) ([]WebhookEndpoint, error) {
 	var webhooks []WebhookEndpoint
-	
+
 	req := c.httpClient.R().
 		SetQueryParams(params.ToMap()).
 		SetResult(&webhooks)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get("/webhooks"))
+
+	_, err := c.doRequest(ctx, "ListWebhooks", "/webhooks", "", "", req.SetContext(ctx).Get("/webhooks"))
 	return webhooks, err
 }
 
 // GetWebhook retrieves a specific webhook endpoint
 func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*WebhookEndpoint, error) {
 	var webhook WebhookEndpoint
-	
+
 	req := c.httpClient.R().
 		SetResult(&webhook)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Get(fmt.Sprintf("/webhooks/%s", webhookID)))
+
+	_, err := c.doRequest(ctx, "GetWebhook", "/webhooks/{webhookID}", "", "", req.SetContext(ctx).Get(fmt.Sprintf("/webhooks/%s", webhookID)))
 	return &webhook, err
 }
 
 // CreateWebhook creates a new webhook endpoint
 func (c *Client) CreateWebhook(ctx context.Context, webhook *WebhookEndpoint) (*WebhookEndpoint, error) {
 	var result WebhookEndpoint
-	
+
 	req := c.httpClient.R().
 		SetBody(webhook).
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post("/webhooks"))
+
+	_, err := c.doRequest(ctx, "CreateWebhook", "/webhooks", "", "", req.SetContext(ctx).Post("/webhooks"))
 	return &result, err
 }
 
 // TestWebhook tests a webhook endpoint
 func (c *Client) TestWebhook(ctx context.Context, webhookID string) (*WebhookTestResult, error) {
 	var result WebhookTestResult
-	
+
 	req := c.httpClient.R().
 		SetResult(&result)
-	
-	_, err := c.doRequest(ctx, req.SetContext(ctx).Post(fmt.Sprintf("/webhooks/%s/test", webhookID)))
+
+	_, err := c.doRequest(ctx, "TestWebhook", "/webhooks/{webhookID}/test", "", "", req.SetContext(ctx).Post(fmt.Sprintf("/webhooks/%s/test", webhookID)))
 	return &result, err
 }
 
@@ -594,4 +674,4 @@ func handleAPIError(response *resty.Response) error {
 		StatusCode: response.StatusCode(),
 		Message:    response.String(),
 	}
-}
\ No newline at end of file
+}
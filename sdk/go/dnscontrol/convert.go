@@ -0,0 +1,88 @@
+package dnscontrol
+
+import (
+	"fmt"
+
+	"github.com/StackExchange/dnscontrol/v3/models"
+
+	atlasdns "github.com/ktheindifferent/AtlasDNS/sdk/go"
+)
+
+// toRecordConfig translates an Atlas Record into dnscontrol's RecordConfig
+// for the record types dnscontrol understands. The returned RecordConfig's
+// Original field is set to the Atlas record ID so later corrections can
+// address it directly.
+func toRecordConfig(domain string, r atlasdns.Record) (*models.RecordConfig, error) {
+	rc := &models.RecordConfig{
+		Type:     r.Type,
+		TTL:      uint32(r.TTL),
+		Original: r.ID,
+	}
+	rc.SetLabelFromFQDN(r.Name, domain)
+
+	switch r.Type {
+	case "A", "AAAA":
+		if err := rc.SetTarget(r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid %s record %q: %w", r.Type, r.Name, err)
+		}
+	case "CNAME", "NS", "PTR":
+		if err := rc.SetTarget(r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid %s record %q: %w", r.Type, r.Name, err)
+		}
+	case "TXT":
+		rc.SetTargetTXT(r.Value)
+	case "MX":
+		if err := rc.SetTargetMX(uint16(r.Priority), r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid MX record %q: %w", r.Name, err)
+		}
+	case "SRV":
+		if err := rc.SetTargetSRV(uint16(r.Priority), uint16(r.Weight), uint16(r.Port), r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid SRV record %q: %w", r.Name, err)
+		}
+	case "CAA":
+		if err := rc.SetTargetCAA(uint8(r.Flag), r.Tag, r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid CAA record %q: %w", r.Name, err)
+		}
+	default:
+		if err := rc.SetTarget(r.Value); err != nil {
+			return nil, fmt.Errorf("atlasdns: invalid %s record %q: %w", r.Type, r.Name, err)
+		}
+	}
+
+	return rc, nil
+}
+
+// toAtlasRecord translates a dnscontrol RecordConfig into the Record shape
+// the Atlas API expects for creation.
+func toAtlasRecord(rc *models.RecordConfig) atlasdns.Record {
+	priority := int(rc.MxPreference)
+	if rc.Type == "SRV" {
+		priority = int(rc.SrvPriority)
+	}
+	return atlasdns.Record{
+		Name:     rc.NameFQDN,
+		Type:     rc.Type,
+		Value:    rc.GetTargetField(),
+		TTL:      int(rc.TTL),
+		Priority: priority,
+		Weight:   int(rc.SrvWeight),
+		Port:     int(rc.SrvPort),
+		Tag:      rc.CaaTag,
+		Flag:     int(rc.CaaFlag),
+	}
+}
+
+// toAtlasRecordUpdate translates a dnscontrol RecordConfig into the partial
+// update payload the Atlas API expects.
+func toAtlasRecordUpdate(rc *models.RecordConfig) *atlasdns.RecordUpdate {
+	r := toAtlasRecord(rc)
+	return &atlasdns.RecordUpdate{
+		Value:    &r.Value,
+		TTL:      &r.TTL,
+		Priority: &r.Priority,
+		Weight:   &r.Weight,
+		Port:     &r.Port,
+		Tag:      &r.Tag,
+		Flag:     &r.Flag,
+	}
+}
@@ -0,0 +1,184 @@
+// Package dnscontrol implements a StackExchange/dnscontrol
+// providers.DNSServiceProvider backed by the Atlas DNS API client, so
+// Atlas DNS zones can be managed declaratively alongside other providers
+// from a single dnsconfig.js.
+package dnscontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/StackExchange/dnscontrol/v3/models"
+	"github.com/StackExchange/dnscontrol/v3/pkg/diff"
+	"github.com/StackExchange/dnscontrol/v3/providers"
+
+	atlasdns "github.com/ktheindifferent/AtlasDNS/sdk/go"
+)
+
+const providerName = "ATLASDNS"
+
+func init() {
+	providers.RegisterDomainServiceProviderType(providerName, providers.DspFuncs{
+		Initializer: newProvider,
+	}, providers.DocumentationNotes{
+		providers.CanUseCAA:   providers.Can(),
+		providers.CanUseSRV:   providers.Can(),
+		providers.CanUsePTR:   providers.Can(),
+		providers.CanGetZones: providers.Can(),
+	})
+}
+
+// Provider implements providers.DNSServiceProvider on top of an Atlas DNS
+// Client.
+type Provider struct {
+	client *atlasdns.Client
+}
+
+// newProvider is the providers.DspFuncs.Initializer used by dnscontrol to
+// construct the provider from a creds.json entry.
+func newProvider(config map[string]string, metadata json.RawMessage) (providers.DNSServiceProvider, error) {
+	apiKey := config["api_key"]
+	baseURL := config["base_url"]
+	if apiKey == "" {
+		return nil, fmt.Errorf("atlasdns: api_key is required in creds.json")
+	}
+	if baseURL == "" {
+		return nil, fmt.Errorf("atlasdns: base_url is required in creds.json")
+	}
+
+	return &Provider{client: atlasdns.NewClient(baseURL, apiKey)}, nil
+}
+
+// GetNameservers returns the zone's nameservers, derived from its NS
+// records at the apex.
+func (p *Provider) GetNameservers(domain string) ([]*models.Nameserver, error) {
+	ctx := context.Background()
+
+	zone, err := p.findZone(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := p.client.ListRecords(ctx, zone.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to list records for %q: %w", domain, err)
+	}
+
+	var nameservers []string
+	for _, r := range records {
+		if r.Type == "NS" && stripDot(r.Name) == stripDot(domain) {
+			nameservers = append(nameservers, r.Value)
+		}
+	}
+
+	return models.ToNameservers(nameservers)
+}
+
+// GetZoneRecords returns the zone's records translated into dnscontrol's
+// RecordConfig, for use by dnscontrol's diffing engine. meta is unused; the
+// Atlas DNS provider has nothing to configure per zone.
+func (p *Provider) GetZoneRecords(domain string, meta map[string]string) (models.Records, error) {
+	ctx := context.Background()
+
+	zone, err := p.findZone(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := p.client.ListRecords(ctx, zone.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to list records for %q: %w", domain, err)
+	}
+
+	existing := make(models.Records, 0, len(records))
+	for _, r := range records {
+		rc, err := toRecordConfig(domain, r)
+		if err != nil {
+			return nil, err
+		}
+		existing = append(existing, rc)
+	}
+
+	return existing, nil
+}
+
+// GetZoneRecordsCorrections computes the minimal set of create/update/delete
+// operations needed to turn existing into dc.Records, and returns them as
+// dnscontrol Corrections that apply the change through the Atlas API.
+func (p *Provider) GetZoneRecordsCorrections(dc *models.DomainConfig, existing models.Records) ([]*models.Correction, error) {
+	ctx := context.Background()
+
+	zone, err := p.findZone(ctx, dc.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, create, toDelete, modify, err := diff.New(dc).IncrementalDiff(existing)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to diff records for %q: %w", dc.Name, err)
+	}
+
+	var corrections []*models.Correction
+
+	if len(create) > 0 {
+		creates := create
+		corrections = append(corrections, &models.Correction{
+			Msg: fmt.Sprintf("Create %d record(s)", len(creates)),
+			F: func() error {
+				batch := make([]atlasdns.Record, 0, len(creates))
+				for _, c := range creates {
+					batch = append(batch, toAtlasRecord(c.Desired))
+				}
+				_, err := p.client.BulkCreateRecords(ctx, zone.ID, batch)
+				return err
+			},
+		})
+	}
+
+	for _, m := range modify {
+		m := m
+		corrections = append(corrections, &models.Correction{
+			Msg: m.String(),
+			F: func() error {
+				update := toAtlasRecordUpdate(m.Desired)
+				_, err := p.client.UpdateRecord(ctx, zone.ID, m.Existing.Original.(string), update)
+				return err
+			},
+		})
+	}
+
+	for _, d := range toDelete {
+		d := d
+		corrections = append(corrections, &models.Correction{
+			Msg: d.String(),
+			F: func() error {
+				return p.client.DeleteRecord(ctx, zone.ID, d.Existing.Original.(string))
+			},
+		})
+	}
+
+	return corrections, nil
+}
+
+// findZone resolves a dnscontrol domain name to an Atlas zone.
+func (p *Provider) findZone(ctx context.Context, domain string) (*atlasdns.Zone, error) {
+	zones, err := p.client.ListZones(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to list zones: %w", err)
+	}
+
+	for i := range zones {
+		if stripDot(zones[i].Name) == stripDot(domain) {
+			return &zones[i], nil
+		}
+	}
+	return nil, fmt.Errorf("atlasdns: no zone found for domain %q", domain)
+}
+
+func stripDot(name string) string {
+	if len(name) > 0 && name[len(name)-1] == '.' {
+		return name[:len(name)-1]
+	}
+	return name
+}
@@ -0,0 +1,192 @@
+package atlasdns
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DNSSECKeyType distinguishes a zone-signing key from a key-signing key.
+type DNSSECKeyType string
+
+const (
+	DNSSECKeyTypeKSK DNSSECKeyType = "KSK"
+	DNSSECKeyTypeZSK DNSSECKeyType = "ZSK"
+)
+
+// DNSSECAlgorithm identifies a DNSSEC signing algorithm by its RFC 8624 /
+// IANA mnemonic.
+type DNSSECAlgorithm string
+
+const (
+	DNSSECAlgorithmECDSAP256SHA256 DNSSECAlgorithm = "ECDSAP256SHA256"
+	DNSSECAlgorithmRSASHA256       DNSSECAlgorithm = "RSASHA256"
+	DNSSECAlgorithmED25519         DNSSECAlgorithm = "ED25519"
+)
+
+// DigestType identifies a DS record digest algorithm (RFC 4509, RFC 5933).
+type DigestType uint8
+
+const (
+	DigestTypeSHA1   DigestType = 1
+	DigestTypeSHA256 DigestType = 2
+	DigestTypeGOST   DigestType = 3
+	DigestTypeSHA384 DigestType = 4
+)
+
+// DSRecord is a parent-zone DS record derived from a DNSSECKey, rendered
+// for every supported digest type so operators can submit whichever one
+// their registrar accepts.
+type DSRecord struct {
+	KeyTag     int
+	Algorithm  DNSSECAlgorithm
+	DigestType DigestType
+	Digest     string
+}
+
+// DNSSECKey describes a single DNSSEC signing key and its lifecycle state.
+type DNSSECKey struct {
+	ID           string
+	ZoneID       string
+	Type         DNSSECKeyType
+	Algorithm    DNSSECAlgorithm
+	KeySize      int
+	Status       string // e.g. "pending", "active", "retired"
+	PublicKey    string
+	Inception    time.Time
+	Activation   time.Time
+	Inactivation time.Time
+	Removal      time.Time
+	DSRecords    []DSRecord
+}
+
+// KeyRequest describes a DNSSEC key to create, including its planned
+// lifecycle timeline.
+type KeyRequest struct {
+	Type         DNSSECKeyType
+	Algorithm    DNSSECAlgorithm
+	KeySize      int
+	Inception    time.Time
+	Activation   time.Time
+	Inactivation time.Time
+	Removal      time.Time
+}
+
+// PublishOpts configures PublishCDSCDNSKEY.
+type PublishOpts struct {
+	// DigestTypes selects which DS digest types to publish as CDS records
+	// alongside the CDNSKEY record. Defaults to SHA-256 if empty.
+	DigestTypes []DigestType
+}
+
+// RollMethod selects the timeline RollDNSSECKey uses when rolling a
+// DNSSEC key, per RFC 7583.
+type RollMethod string
+
+const (
+	// RollMethodPrePublish is the standard ZSK roll: publish the new key
+	// alongside the old one, wait for propagation, then switch signing
+	// over and retire the old key.
+	RollMethodPrePublish RollMethod = "pre-publish"
+
+	// RollMethodDoubleSignature signs with both old and new KSK during
+	// the transition so validators trust either.
+	RollMethodDoubleSignature RollMethod = "double-signature"
+
+	// RollMethodDoubleDS requires the parent to publish DS records for
+	// both old and new KSK during the transition.
+	RollMethodDoubleDS RollMethod = "double-ds"
+)
+
+// KeyRollResult describes the schedule the server will follow for a
+// requested key roll, so operators can track or alert on each stage.
+type KeyRollResult struct {
+	OldKeyID string
+	NewKeyID string
+	Method   RollMethod
+	Stages   []KeyRollStage
+}
+
+// KeyRollStage is a single scheduled step of a key roll (e.g. "publish new
+// DNSKEY", "submit DS to parent", "retire old key") with the time the
+// server expects to perform it.
+type KeyRollStage struct {
+	Name string
+	At   time.Time
+}
+
+// ListDNSSECKeys returns all DNSSEC keys for a zone, including retired
+// ones still within their RFC 7583 removal window.
+func (c *Client) ListDNSSECKeys(ctx context.Context, zoneID string) ([]DNSSECKey, error) {
+	var keys []DNSSECKey
+
+	req := c.httpClient.R().
+		SetResult(&keys)
+
+	_, err := c.doRequest(ctx, "ListDNSSECKeys", "/zones/{zoneID}/dnssec/keys", zoneID, "", req.SetContext(ctx).Get(fmt.Sprintf("/zones/%s/dnssec/keys", zoneID)))
+	return keys, err
+}
+
+// CreateDNSSECKey creates a new DNSSEC key (KSK or ZSK) for a zone with the
+// given lifecycle timeline.
+func (c *Client) CreateDNSSECKey(ctx context.Context, zoneID string, request *KeyRequest) (*DNSSECKey, error) {
+	var key DNSSECKey
+
+	req := c.httpClient.R().
+		SetBody(request).
+		SetResult(&key)
+
+	_, err := c.doRequest(ctx, "CreateDNSSECKey", "/zones/{zoneID}/dnssec/keys", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/keys", zoneID)))
+	return &key, err
+}
+
+// ActivateDNSSECKey moves a key from "pending" into active signing use.
+func (c *Client) ActivateDNSSECKey(ctx context.Context, zoneID, keyID string) (*DNSSECKey, error) {
+	var key DNSSECKey
+
+	req := c.httpClient.R().
+		SetResult(&key)
+
+	_, err := c.doRequest(ctx, "ActivateDNSSECKey", "/zones/{zoneID}/dnssec/keys/{keyID}/activate", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/keys/%s/activate", zoneID, keyID)))
+	return &key, err
+}
+
+// RetireDNSSECKey marks a key inactive. It remains published until its
+// Removal time so in-flight signatures and cached DS records stay valid.
+func (c *Client) RetireDNSSECKey(ctx context.Context, zoneID, keyID string) (*DNSSECKey, error) {
+	var key DNSSECKey
+
+	req := c.httpClient.R().
+		SetResult(&key)
+
+	_, err := c.doRequest(ctx, "RetireDNSSECKey", "/zones/{zoneID}/dnssec/keys/{keyID}/retire", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/keys/%s/retire", zoneID, keyID)))
+	return &key, err
+}
+
+// PublishCDSCDNSKEY publishes RFC 7344/8078 CDS and CDNSKEY records for a
+// zone's current KSK, signaling the parent (or an automated registrar) to
+// pull the corresponding DS update.
+func (c *Client) PublishCDSCDNSKEY(ctx context.Context, zoneID string, opts PublishOpts) error {
+	if len(opts.DigestTypes) == 0 {
+		opts.DigestTypes = []DigestType{DigestTypeSHA256}
+	}
+
+	req := c.httpClient.R().
+		SetBody(opts)
+
+	_, err := c.doRequest(ctx, "PublishCDSCDNSKEY", "/zones/{zoneID}/dnssec/cds-cdnskey", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/cds-cdnskey", zoneID)))
+	return err
+}
+
+// RollDNSSECKey rolls keyID using the given RollMethod, returning the
+// timeline the server will follow so operators can schedule around it.
+func (c *Client) RollDNSSECKey(ctx context.Context, zoneID, keyID string, method RollMethod) (*KeyRollResult, error) {
+	var result KeyRollResult
+
+	req := c.httpClient.R().
+		SetBody(map[string]string{"method": string(method)}).
+		SetResult(&result)
+
+	_, err := c.doRequest(ctx, "RollDNSSECKey", "/zones/{zoneID}/dnssec/keys/{keyID}/roll", zoneID, "", req.SetContext(ctx).Post(fmt.Sprintf("/zones/%s/dnssec/keys/%s/roll", zoneID, keyID)))
+	return &result, err
+}
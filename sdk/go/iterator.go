@@ -0,0 +1,185 @@
+package atlasdns
+
+import "context"
+
+// Iterator transparently pages through a List* endpoint, following the
+// Next/Value/Err loop used by most cloud SDKs (e.g. Oracle OCI). It fetches
+// one page at a time as Next is called, respects context cancellation, and
+// goes through the same rate limiter as every other client call since it
+// drives the underlying List* method.
+type Iterator[T any] struct {
+	ctx    context.Context
+	params *ListParams
+	fetch  func(ctx context.Context, params *ListParams) ([]T, error)
+
+	page      int
+	buf       []T
+	pos       int
+	current   T
+	err       error
+	exhausted bool
+}
+
+// newIterator constructs an Iterator over fetch, starting from a copy of
+// params so repeated Next calls can mutate the page number without
+// surprising the caller's original ListParams.
+func newIterator[T any](ctx context.Context, params *ListParams, fetch func(context.Context, *ListParams) ([]T, error)) *Iterator[T] {
+	var p ListParams
+	if params != nil {
+		p = *params
+	}
+	return &Iterator[T]{ctx: ctx, params: &p, fetch: fetch}
+}
+
+// Next advances the iterator, fetching the next page if the current one is
+// exhausted. It returns false at the end of the list or on error; callers
+// must check Err to distinguish the two.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if it.pos < len(it.buf) {
+		it.current = it.buf[it.pos]
+		it.pos++
+		return true
+	}
+
+	if it.exhausted {
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.params.Page = it.page + 1
+	page, err := it.fetch(it.ctx, it.params)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.page++
+	it.buf = page
+	it.pos = 0
+
+	if len(page) == 0 || (it.params.PerPage > 0 && len(page) < it.params.PerPage) {
+		it.exhausted = true
+	}
+	if len(page) == 0 {
+		return false
+	}
+
+	it.current = it.buf[0]
+	it.pos = 1
+	return true
+}
+
+// Value returns the element most recently produced by Next.
+func (it *Iterator[T]) Value() T { return it.current }
+
+// Err returns the error that stopped iteration, if any.
+func (it *Iterator[T]) Err() error { return it.err }
+
+// Page returns the last page number fetched, starting at 1.
+func (it *Iterator[T]) Page() int { return it.page }
+
+// All returns a Go 1.23 range-over-func adapter so callers can write
+// "for v := range it.All() { ... }" instead of a manual Next/Value loop.
+func (it *Iterator[T]) All() func(yield func(T) bool) {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// CollectAll drains it into a slice. It's a convenience for small result
+// sets where the caller doesn't need streaming semantics.
+func CollectAll[T any](it *Iterator[T]) ([]T, error) {
+	var all []T
+	for it.Next() {
+		all = append(all, it.Value())
+	}
+	return all, it.Err()
+}
+
+// ZoneIterator pages through ListZones.
+type ZoneIterator = Iterator[Zone]
+
+// RecordIterator pages through ListRecords for a single zone.
+type RecordIterator = Iterator[Record]
+
+// HealthCheckIterator pages through ListHealthChecks.
+type HealthCheckIterator = Iterator[HealthCheck]
+
+// ZoneService provides iterator-based access to zones, via client.Zones().
+type ZoneService struct {
+	client *Client
+}
+
+// Zones returns a ZoneService for iterating all zones.
+func (c *Client) Zones() *ZoneService {
+	return &ZoneService{client: c}
+}
+
+// Iter returns a ZoneIterator that transparently pages through ListZones.
+func (s *ZoneService) Iter(ctx context.Context, params *ListParams) *ZoneIterator {
+	return newIterator(ctx, params, s.client.ListZones)
+}
+
+// All returns a range-over-func adapter over all zones.
+func (s *ZoneService) All(ctx context.Context, params *ListParams) func(yield func(Zone) bool) {
+	return s.Iter(ctx, params).All()
+}
+
+// RecordService provides iterator-based access to a single zone's records,
+// via client.Records(zoneID).
+type RecordService struct {
+	client *Client
+	zoneID string
+}
+
+// Records returns a RecordService for iterating zoneID's records.
+func (c *Client) Records(zoneID string) *RecordService {
+	return &RecordService{client: c, zoneID: zoneID}
+}
+
+// Iter returns a RecordIterator that transparently pages through
+// ListRecords for this service's zone.
+func (s *RecordService) Iter(ctx context.Context, params *ListParams) *RecordIterator {
+	return newIterator(ctx, params, func(ctx context.Context, p *ListParams) ([]Record, error) {
+		return s.client.ListRecords(ctx, s.zoneID, p)
+	})
+}
+
+// All returns a range-over-func adapter over all of the zone's records.
+func (s *RecordService) All(ctx context.Context, params *ListParams) func(yield func(Record) bool) {
+	return s.Iter(ctx, params).All()
+}
+
+// HealthCheckService provides iterator-based access to health checks, via
+// client.HealthChecks().
+type HealthCheckService struct {
+	client *Client
+}
+
+// HealthChecks returns a HealthCheckService for iterating all health
+// checks.
+func (c *Client) HealthChecks() *HealthCheckService {
+	return &HealthCheckService{client: c}
+}
+
+// Iter returns a HealthCheckIterator that transparently pages through
+// ListHealthChecks.
+func (s *HealthCheckService) Iter(ctx context.Context, params *ListParams) *HealthCheckIterator {
+	return newIterator(ctx, params, s.client.ListHealthChecks)
+}
+
+// All returns a range-over-func adapter over all health checks.
+func (s *HealthCheckService) All(ctx context.Context, params *ListParams) func(yield func(HealthCheck) bool) {
+	return s.Iter(ctx, params).All()
+}
@@ -0,0 +1,161 @@
+package atlasdns
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerRequestID is the header used to propagate a request's correlation
+// ID to the Atlas DNS server and to echo it back in APIError.
+const headerRequestID = "X-Request-ID"
+
+// defaultRateLimitWaitThreshold is how long doRequest can block on the
+// rate limiter before it counts as a rate-limited request for the
+// atlasdns.client.rate_limited metric.
+const defaultRateLimitWaitThreshold = 50 * time.Millisecond
+
+const instrumentationName = "atlasdns"
+
+// noopMeterProvider returns a MeterProvider that creates no-op instruments,
+// used as the Client default so metrics recording is always safe to call
+// even when the caller hasn't configured a real MeterProvider.
+func noopMeterProvider() metric.MeterProvider {
+	return noop.NewMeterProvider()
+}
+
+// initInstrumentation derives the tracer and metric instruments from the
+// client's configured providers. Called once from NewClient after options
+// have been applied.
+func (c *Client) initInstrumentation() {
+	c.tracer = c.tracerProvider.Tracer(instrumentationName)
+
+	meter := c.meterProvider.Meter(instrumentationName)
+
+	duration, err := meter.Float64Histogram(
+		"atlasdns.client.request.duration",
+		metric.WithDescription("Duration of Atlas DNS API requests"),
+		metric.WithUnit("s"),
+	)
+	if err == nil {
+		c.requestDuration = duration
+	}
+
+	rateLimited, err := meter.Int64Counter(
+		"atlasdns.client.rate_limited",
+		metric.WithDescription("Number of requests that were blocked by the client-side rate limiter past the configured threshold"),
+	)
+	if err == nil {
+		c.rateLimitedCounter = rateLimited
+	}
+}
+
+// requestSpan wraps a trace.Span so doRequest can record its outcome
+// without every call site needing to import the tracing API.
+type requestSpan struct {
+	span trace.Span
+}
+
+// startSpan starts a span named "atlasdns.<operation>" carrying the
+// attributes callers can use to correlate a request with server-side logs.
+// zoneID and recordID are omitted when the call isn't scoped to one (e.g.
+// ListZones has neither).
+func (c *Client) startSpan(ctx context.Context, operation, route, httpMethod, requestID, zoneID, recordID string) (context.Context, requestSpan) {
+	attrs := []attribute.KeyValue{
+		attribute.String("http.method", httpMethod),
+		attribute.String("http.route", route),
+		attribute.String("atlas.request_id", requestID),
+	}
+	if zoneID != "" {
+		attrs = append(attrs, attribute.String("atlas.zone_id", zoneID))
+	}
+	if recordID != "" {
+		attrs = append(attrs, attribute.String("atlas.record_id", recordID))
+	}
+
+	ctx, span := c.tracer.Start(ctx, "atlasdns."+operation, trace.WithAttributes(attrs...))
+	return ctx, requestSpan{span: span}
+}
+
+func (s requestSpan) setStatusCode(statusCode int) {
+	if statusCode == 0 {
+		return
+	}
+	s.span.SetAttributes(attribute.Int("http.status_code", statusCode))
+	if statusCode >= 400 {
+		s.span.SetStatus(codes.Error, "")
+	}
+}
+
+func (s requestSpan) recordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s requestSpan) end() {
+	s.span.End()
+}
+
+// recordRequestDuration records the atlasdns.client.request.duration
+// histogram for a completed request.
+func (c *Client) recordRequestDuration(ctx context.Context, route string, statusCode int, duration time.Duration) {
+	if c.requestDuration == nil {
+		return
+	}
+	c.requestDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+		attribute.String("http.route", route),
+		attribute.Int("http.status_code", statusCode),
+	))
+}
+
+// recordRateLimitWait increments atlasdns.client.rate_limited when the
+// internal rate.Limiter blocked the caller for longer than
+// rateLimitWaitThreshold.
+func (c *Client) recordRateLimitWait(ctx context.Context, route string, waited time.Duration) {
+	if c.rateLimitedCounter == nil || waited < c.rateLimitWaitThreshold {
+		return
+	}
+	c.rateLimitedCounter.Add(ctx, 1, metric.WithAttributes(attribute.String("http.route", route)))
+}
+
+// logRequest emits a structured log line for a completed request: at debug
+// level on success, at error level when the request failed.
+func (c *Client) logRequest(ctx context.Context, operation, route, requestID string, statusCode int, duration time.Duration, err error) {
+	if c.logger == nil {
+		return
+	}
+
+	attrs := []any{
+		slog.String("operation", operation),
+		slog.String("route", route),
+		slog.String("request_id", requestID),
+		slog.Int("status_code", statusCode),
+		slog.Duration("duration", duration),
+	}
+	if err != nil {
+		c.logger.ErrorContext(ctx, "atlasdns request failed", append(attrs, slog.String("error", err.Error()))...)
+		return
+	}
+	c.logger.DebugContext(ctx, "atlasdns request", attrs...)
+}
+
+// newRequestID generates a random hex-encoded correlation ID for a request
+// that didn't already carry one.
+func newRequestID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand.Read only fails if the OS entropy source is
+		// unavailable; fall back to a fixed, clearly-bogus ID rather than
+		// panicking mid-request.
+		return "00000000000000000000000000000000"
+	}
+	return hex.EncodeToString(b[:])
+}
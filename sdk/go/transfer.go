@@ -0,0 +1,243 @@
+package atlasdns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// transferChunkSize mirrors importChunkSize: the number of records sent per
+// BulkCreateRecords call while draining an AXFR/IXFR stream.
+const transferChunkSize = 500
+
+// transferMaxRetries and transferBaseBackoff bound the exponential backoff
+// used when a chunk upload fails mid-transfer.
+const (
+	transferMaxRetries  = 5
+	transferBaseBackoff = 500 * time.Millisecond
+)
+
+// TransferOptions configures an inbound zone transfer.
+type TransferOptions struct {
+	// SourceNS is the "host:port" of the authoritative server to transfer
+	// from. Port defaults to 53 if omitted.
+	SourceNS string
+
+	// TSIGKey, TSIGSecret, and TSIGAlgorithm configure RFC 8945 TSIG
+	// signing of the transfer request. TSIGAlgorithm defaults to
+	// dns.HmacSHA256 when TSIGKey is set and TSIGAlgorithm is empty.
+	TSIGKey       string
+	TSIGSecret    string
+	TSIGAlgorithm string
+
+	// Incremental requests an IXFR instead of a full AXFR. SerialFrom must
+	// be set to the zone's last known serial.
+	Incremental bool
+	SerialFrom  uint32
+}
+
+// TransferResult summarizes the outcome of an inbound zone transfer
+// (TransferZoneIn).
+type TransferResult struct {
+	RecordsTransferred int
+	Serial             uint32
+	Errors             []error
+}
+
+// TransferZoneIn pulls zoneID's records from opts.SourceNS via AXFR (or
+// IXFR when opts.Incremental is set) and uploads them into zoneID via
+// BulkCreateRecords.
+func (c *Client) TransferZoneIn(ctx context.Context, zoneID string, opts TransferOptions) (*TransferResult, error) {
+	zone, err := c.GetZone(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to get zone: %w", err)
+	}
+
+	origin := dns.Fqdn(zone.Name)
+	sourceNS := opts.SourceNS
+	if _, _, err := net.SplitHostPort(sourceNS); err != nil {
+		sourceNS = net.JoinHostPort(sourceNS, "53")
+	}
+
+	msg := new(dns.Msg)
+	if opts.Incremental {
+		msg.SetIxfr(origin, opts.SerialFrom, "", "")
+	} else {
+		msg.SetAxfr(origin)
+	}
+
+	transfer := &dns.Transfer{}
+	if opts.TSIGKey != "" {
+		algo := opts.TSIGAlgorithm
+		if algo == "" {
+			algo = dns.HmacSHA256
+		}
+		keyName := dns.Fqdn(opts.TSIGKey)
+		msg.SetTsig(keyName, algo, 300, time.Now().Unix())
+		transfer.TsigSecret = map[string]string{keyName: opts.TSIGSecret}
+	}
+
+	envelopes, err := transfer.In(msg, sourceNS)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to start transfer from %s: %w", sourceNS, err)
+	}
+
+	result := &TransferResult{}
+	var (
+		chunk    []Record
+		soaSeen  int
+		firstSOA *dns.SOA
+		lastSOA  *dns.SOA
+	)
+
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		if err := c.bulkCreateWithRetry(ctx, zoneID, chunk); err != nil {
+			return err
+		}
+		result.RecordsTransferred += len(chunk)
+		chunk = chunk[:0]
+		return nil
+	}
+
+	for env := range envelopes {
+		if env.Error != nil {
+			return result, fmt.Errorf("atlasdns: transfer error: %w", env.Error)
+		}
+
+		for _, rr := range env.RR {
+			if soa, ok := rr.(*dns.SOA); ok {
+				soaSeen++
+				if firstSOA == nil {
+					firstSOA = soa
+					continue // the opening SOA is a framing record, not zone data
+				}
+				lastSOA = soa
+				// A full AXFR ends with a repeat of the opening SOA; any
+				// SOA seen before that closing one (other than the
+				// first) means the upstream sent more than one
+				// zone/version in a single stream, which we reject. An
+				// IXFR response legitimately brackets each historical
+				// diff with its own SOA pair (RFC 1995), so this check
+				// only applies to a full AXFR.
+				if soaSeen > 2 && !opts.Incremental {
+					return result, fmt.Errorf("atlasdns: unexpected mid-stream SOA for %s (multi-zone or multi-version transfer)", origin)
+				}
+				continue
+			}
+
+			record, skip, err := rrToRecord(rr)
+			if skip || err != nil {
+				if err != nil {
+					result.Errors = append(result.Errors, err)
+				}
+				continue
+			}
+			if record.TTL == 0 && firstSOA != nil {
+				// RFC 1035 §3.6.2/RFC 2308: a record with no explicit TTL
+				// inherits the zone's SOA Minimum.
+				record.TTL = int(firstSOA.Minttl)
+			}
+			chunk = append(chunk, record)
+			if len(chunk) >= transferChunkSize {
+				if err := flush(); err != nil {
+					return result, err
+				}
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return result, err
+	}
+
+	if firstSOA == nil {
+		return result, fmt.Errorf("atlasdns: transfer from %s returned no SOA", sourceNS)
+	}
+	if lastSOA != nil && lastSOA.Serial != firstSOA.Serial {
+		result.Errors = append(result.Errors, fmt.Errorf("atlasdns: SOA serial changed during transfer (%d -> %d); zone may be inconsistent", firstSOA.Serial, lastSOA.Serial))
+	}
+	result.Serial = firstSOA.Serial
+
+	return result, nil
+}
+
+// TransferZoneOut streams zoneID to w as an AXFR-style record sequence for
+// backup tooling: a synthesized apex SOA, every record, then the same SOA
+// again, one RR per line in presentation (text) format, not DNS wire format.
+func (c *Client) TransferZoneOut(ctx context.Context, zoneID string, w io.Writer) error {
+	zone, err := c.GetZone(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("atlasdns: failed to get zone: %w", err)
+	}
+	origin := dns.Fqdn(zone.Name)
+
+	soa, err := synthesizeSOA(origin, 3600)
+	if err != nil {
+		return fmt.Errorf("atlasdns: failed to synthesize SOA: %w", err)
+	}
+	if _, err := io.WriteString(w, soa.String()+"\n"); err != nil {
+		return err
+	}
+
+	page := 1
+	const perPage = 500
+	for {
+		records, err := c.ListRecords(ctx, zoneID, &ListParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return fmt.Errorf("atlasdns: failed to list records: %w", err)
+		}
+		if len(records) == 0 {
+			break
+		}
+
+		for _, record := range records {
+			rr, err := recordToRR(record, origin, 3600)
+			if err != nil {
+				return fmt.Errorf("atlasdns: failed to render record %q: %w", record.Name, err)
+			}
+			if _, err := io.WriteString(w, rr.String()+"\n"); err != nil {
+				return err
+			}
+		}
+
+		if len(records) < perPage {
+			break
+		}
+		page++
+	}
+
+	// An AXFR stream closes with the same SOA it opened with.
+	_, err = io.WriteString(w, soa.String()+"\n")
+	return err
+}
+
+// bulkCreateWithRetry wraps BulkCreateRecords with exponential backoff,
+// layered on top of the client's existing rate limiter, to ride out
+// transient failures partway through a long transfer.
+func (c *Client) bulkCreateWithRetry(ctx context.Context, zoneID string, records []Record) error {
+	var lastErr error
+	for attempt := 0; attempt < transferMaxRetries; attempt++ {
+		if attempt > 0 {
+			backoff := transferBaseBackoff * time.Duration(1<<uint(attempt-1))
+			select {
+			case <-time.After(backoff):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if _, err := c.BulkCreateRecords(ctx, zoneID, records); err != nil {
+			lastErr = err
+			continue
+		}
+		return nil
+	}
+	return fmt.Errorf("atlasdns: bulk create failed after %d attempts: %w", transferMaxRetries, lastErr)
+}
@@ -0,0 +1,329 @@
+package atlasdns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Severity classifies how serious a ValidationIssue is.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// IssueCode identifies the specific rule a ValidationIssue came from, so
+// callers can filter or allowlist known-acceptable findings in CI.
+type IssueCode string
+
+const (
+	IssueCodeCNAMECoexistence   IssueCode = "cname_coexistence"
+	IssueCodeDuplicateSingleton IssueCode = "duplicate_singleton"
+	IssueCodeCNAMEChain         IssueCode = "cname_chain"
+	IssueCodeTTLMismatch        IssueCode = "ttl_mismatch"
+	IssueCodeWildcardShadow     IssueCode = "wildcard_shadow"
+	IssueCodeCNAMEAtApex        IssueCode = "cname_at_apex"
+	IssueCodeMissingGlue        IssueCode = "missing_glue"
+)
+
+// ValidationIssue is a single problem found by RecordSet.Validate, indexed
+// back to the record that triggered it.
+type ValidationIssue struct {
+	Severity    Severity
+	Code        IssueCode
+	RecordIndex int
+	Message     string
+}
+
+// RecordSet is a client-side collection of records to check for conflicts
+// before sending them to BulkCreateRecords, the same way CoreDNS's zone
+// config parser catches overlapping RRsets before the zone loads.
+type RecordSet struct {
+	apex    string
+	records []Record
+}
+
+// NewRecordSet returns an empty RecordSet for the zone named apex (used to
+// detect zone-apex-specific issues like a CNAME at the apex).
+func NewRecordSet(apex string) *RecordSet {
+	return &RecordSet{apex: normalizeName(apex)}
+}
+
+// Add appends record to the set. It only rejects records that are
+// structurally incomplete; conflict detection happens in Validate, once
+// the whole set is known.
+func (rs *RecordSet) Add(record Record) error {
+	if record.Name == "" {
+		return fmt.Errorf("atlasdns: record missing name")
+	}
+	if record.Type == "" {
+		return fmt.Errorf("atlasdns: record %q missing type", record.Name)
+	}
+	rs.records = append(rs.records, record)
+	return nil
+}
+
+// Validate runs every overlap/conflict check against the records added so
+// far and returns the issues found. An empty result means the set is safe
+// to upload.
+func (rs *RecordSet) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+
+	byName := make(map[string][]int)
+	for i, r := range rs.records {
+		name := normalizeName(r.Name)
+		byName[name] = append(byName[name], i)
+	}
+
+	issues = append(issues, rs.checkCNAMECoexistence(byName)...)
+	issues = append(issues, rs.checkDuplicateSingletons(byName)...)
+	issues = append(issues, rs.checkCNAMEChains(byName)...)
+	issues = append(issues, rs.checkTTLMismatches(byName)...)
+	issues = append(issues, rs.checkWildcardShadowing(byName)...)
+	issues = append(issues, rs.checkDNSSECHostileApex()...)
+	issues = append(issues, rs.checkMissingGlue(byName)...)
+
+	return issues
+}
+
+// checkCNAMECoexistence flags any owner name that has a CNAME alongside
+// any other record type, which RFC 1034 §3.6.2 forbids.
+func (rs *RecordSet) checkCNAMECoexistence(byName map[string][]int) []ValidationIssue {
+	var issues []ValidationIssue
+	for name, idxs := range byName {
+		hasCNAME, hasOther := false, false
+		for _, i := range idxs {
+			if rs.records[i].Type == "CNAME" {
+				hasCNAME = true
+			} else {
+				hasOther = true
+			}
+		}
+		if hasCNAME && hasOther {
+			for _, i := range idxs {
+				issues = append(issues, ValidationIssue{
+					Severity:    SeverityError,
+					Code:        IssueCodeCNAMECoexistence,
+					RecordIndex: i,
+					Message:     fmt.Sprintf("%q has a CNAME alongside other records; CNAME must be the only RRset at its owner name", name),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkDuplicateSingletons flags more than one SOA or more than one CNAME
+// for the same owner name, both of which must be unique.
+func (rs *RecordSet) checkDuplicateSingletons(byName map[string][]int) []ValidationIssue {
+	var issues []ValidationIssue
+	for name, idxs := range byName {
+		var soas, cnames []int
+		for _, i := range idxs {
+			switch rs.records[i].Type {
+			case "SOA":
+				soas = append(soas, i)
+			case "CNAME":
+				cnames = append(cnames, i)
+			}
+		}
+		for _, group := range [][]int{soas, cnames} {
+			if len(group) > 1 {
+				for _, i := range group {
+					issues = append(issues, ValidationIssue{
+						Severity:    SeverityError,
+						Code:        IssueCodeDuplicateSingleton,
+						RecordIndex: i,
+						Message:     fmt.Sprintf("%q has %d %s records; only one is allowed", name, len(group), rs.records[i].Type),
+					})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// checkCNAMEChains flags MX, SRV, or CNAME records whose target is itself
+// a CNAME owner within this set. A record whose target is its own owner
+// name is not flagged here.
+func (rs *RecordSet) checkCNAMEChains(byName map[string][]int) []ValidationIssue {
+	var issues []ValidationIssue
+	for i, r := range rs.records {
+		if r.Type != "MX" && r.Type != "SRV" && r.Type != "CNAME" {
+			continue
+		}
+		target := normalizeName(r.Value)
+		if target == normalizeName(r.Name) {
+			continue
+		}
+		for _, j := range byName[target] {
+			if rs.records[j].Type == "CNAME" {
+				issues = append(issues, ValidationIssue{
+					Severity:    SeverityError,
+					Code:        IssueCodeCNAMEChain,
+					RecordIndex: i,
+					Message:     fmt.Sprintf("%s record %q targets %q, which is itself a CNAME", r.Type, r.Name, r.Value),
+				})
+			}
+		}
+	}
+	return issues
+}
+
+// checkTTLMismatches flags records in the same RRset (same owner name and
+// type) that disagree on TTL.
+func (rs *RecordSet) checkTTLMismatches(byName map[string][]int) []ValidationIssue {
+	var issues []ValidationIssue
+	for name, idxs := range byName {
+		byType := make(map[string][]int)
+		for _, i := range idxs {
+			byType[rs.records[i].Type] = append(byType[rs.records[i].Type], i)
+		}
+		for rtype, group := range byType {
+			if len(group) < 2 {
+				continue
+			}
+			ttl := rs.records[group[0]].TTL
+			for _, i := range group[1:] {
+				if rs.records[i].TTL != ttl {
+					issues = append(issues, ValidationIssue{
+						Severity:    SeverityWarning,
+						Code:        IssueCodeTTLMismatch,
+						RecordIndex: i,
+						Message:     fmt.Sprintf("%s %s RRset has mismatched TTLs (%d vs %d)", name, rtype, ttl, rs.records[i].TTL),
+					})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// checkWildcardShadowing warns when a wildcard owner name and a more
+// specific name of the same type both exist, since that's frequently an
+// operator mistake even though specific names always win at query time.
+func (rs *RecordSet) checkWildcardShadowing(byName map[string][]int) []ValidationIssue {
+	var issues []ValidationIssue
+	for name, idxs := range byName {
+		if !strings.HasPrefix(name, "*.") {
+			continue
+		}
+		suffix := strings.TrimPrefix(name, "*.")
+		for other, otherIdxs := range byName {
+			if other == name || !strings.HasSuffix(other, "."+suffix) {
+				continue
+			}
+			for _, i := range idxs {
+				for _, j := range otherIdxs {
+					if rs.records[i].Type == rs.records[j].Type {
+						issues = append(issues, ValidationIssue{
+							Severity:    SeverityWarning,
+							Code:        IssueCodeWildcardShadow,
+							RecordIndex: j,
+							Message:     fmt.Sprintf("%q shadows wildcard %q for type %s", other, name, rs.records[j].Type),
+						})
+					}
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// checkDNSSECHostileApex flags a CNAME at the zone apex, which breaks
+// DNSSEC (and plain DNS) since the apex must also carry SOA/NS records.
+func (rs *RecordSet) checkDNSSECHostileApex() []ValidationIssue {
+	if rs.apex == "" {
+		return nil
+	}
+	var issues []ValidationIssue
+	for i, r := range rs.records {
+		if r.Type == "CNAME" && normalizeName(r.Name) == rs.apex {
+			issues = append(issues, ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeCNAMEAtApex,
+				RecordIndex: i,
+				Message:     "CNAME at the zone apex is not allowed; it must coexist with SOA/NS records",
+			})
+		}
+	}
+	return issues
+}
+
+// checkMissingGlue flags NS records whose target is in-bailiwick (a
+// subdomain of the zone) but has no corresponding A/AAAA record in the
+// set, which would leave resolvers unable to find that nameserver.
+func (rs *RecordSet) checkMissingGlue(byName map[string][]int) []ValidationIssue {
+	if rs.apex == "" {
+		return nil
+	}
+	var issues []ValidationIssue
+	for i, r := range rs.records {
+		if r.Type != "NS" {
+			continue
+		}
+		target := normalizeName(r.Value)
+		if target != rs.apex && !strings.HasSuffix(target, "."+rs.apex) {
+			continue // out-of-bailiwick: the parent/TLD handles glue for this
+		}
+		hasGlue := false
+		for _, j := range byName[target] {
+			if rs.records[j].Type == "A" || rs.records[j].Type == "AAAA" {
+				hasGlue = true
+				break
+			}
+		}
+		if !hasGlue {
+			issues = append(issues, ValidationIssue{
+				Severity:    SeverityError,
+				Code:        IssueCodeMissingGlue,
+				RecordIndex: i,
+				Message:     fmt.Sprintf("NS record %q targets in-bailiwick %q with no A/AAAA glue record", r.Name, r.Value),
+			})
+		}
+	}
+	return issues
+}
+
+// normalizeName lowercases and strips the trailing dot so names can be
+// compared regardless of how they were entered.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
+
+// ValidateZoneLocally runs the same checks as RecordSet.Validate against
+// zoneID's current records, pulled via ListRecords, as a faster local
+// alternative to the server-side ValidateZone endpoint.
+func (c *Client) ValidateZoneLocally(ctx context.Context, zoneID string) ([]ValidationIssue, error) {
+	zone, err := c.GetZone(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("atlasdns: failed to get zone: %w", err)
+	}
+
+	rs := NewRecordSet(zone.Name)
+
+	page := 1
+	const perPage = 500
+	for {
+		records, err := c.ListRecords(ctx, zoneID, &ListParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return nil, fmt.Errorf("atlasdns: failed to list records: %w", err)
+		}
+		if len(records) == 0 {
+			break
+		}
+		for _, r := range records {
+			if err := rs.Add(r); err != nil {
+				return nil, err
+			}
+		}
+		if len(records) < perPage {
+			break
+		}
+		page++
+	}
+
+	return rs.Validate(), nil
+}
@@ -0,0 +1,32 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Emit signs event with secret and POSTs it to url, the same way Atlas DNS
+// would deliver a real webhook. It's meant for tests that exercise a
+// Receiver end-to-end without standing up the actual Atlas DNS server.
+func Emit(ctx context.Context, url, secret string, event Event) (*http.Response, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: failed to marshal event: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	signature := sign([]byte(secret), timestamp, body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(signatureHeader, fmt.Sprintf("t=%d,v1=%s", timestamp, signature))
+
+	return http.DefaultClient.Do(req)
+}
@@ -0,0 +1,81 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	atlasdns "github.com/ktheindifferent/AtlasDNS/sdk/go"
+)
+
+// EventType identifies the kind of change a webhook Event describes.
+type EventType string
+
+const (
+	EventZoneCreated          EventType = "zone.created"
+	EventZoneUpdated          EventType = "zone.updated"
+	EventRecordCreated        EventType = "record.created"
+	EventRecordUpdated        EventType = "record.updated"
+	EventRecordDeleted        EventType = "record.deleted"
+	EventHealthCheckFailed    EventType = "healthcheck.failed"
+	EventHealthCheckRecovered EventType = "healthcheck.recovered"
+	EventDNSSECKeysRotated    EventType = "dnssec.keys_rotated"
+)
+
+// Event is the envelope every Atlas DNS webhook delivery is wrapped in.
+// Data holds the type-specific payload and should be decoded with the
+// struct matching Type (see ZoneCreatedEvent and friends below); On does
+// this automatically for registered handlers.
+type Event struct {
+	ID        string          `json:"id"`
+	Type      EventType       `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// ZoneCreatedEvent is the Data payload for EventZoneCreated.
+type ZoneCreatedEvent struct {
+	Zone atlasdns.Zone `json:"zone"`
+}
+
+// ZoneUpdatedEvent is the Data payload for EventZoneUpdated.
+type ZoneUpdatedEvent struct {
+	Zone atlasdns.Zone `json:"zone"`
+}
+
+// RecordCreatedEvent is the Data payload for EventRecordCreated.
+type RecordCreatedEvent struct {
+	ZoneID string         `json:"zone_id"`
+	Record atlasdns.Record `json:"record"`
+}
+
+// RecordUpdatedEvent is the Data payload for EventRecordUpdated.
+type RecordUpdatedEvent struct {
+	ZoneID string          `json:"zone_id"`
+	Record atlasdns.Record `json:"record"`
+}
+
+// RecordDeletedEvent is the Data payload for EventRecordDeleted.
+type RecordDeletedEvent struct {
+	ZoneID   string `json:"zone_id"`
+	RecordID string `json:"record_id"`
+}
+
+// HealthCheckFailedEvent is the Data payload for EventHealthCheckFailed.
+type HealthCheckFailedEvent struct {
+	HealthCheck atlasdns.HealthCheck       `json:"health_check"`
+	Result      atlasdns.HealthCheckResult `json:"result"`
+}
+
+// HealthCheckRecoveredEvent is the Data payload for
+// EventHealthCheckRecovered.
+type HealthCheckRecoveredEvent struct {
+	HealthCheck atlasdns.HealthCheck       `json:"health_check"`
+	Result      atlasdns.HealthCheckResult `json:"result"`
+}
+
+// DNSSECKeysRotatedEvent is the Data payload for EventDNSSECKeysRotated.
+type DNSSECKeysRotatedEvent struct {
+	ZoneID  string               `json:"zone_id"`
+	OldKeys []atlasdns.DNSSECKey `json:"old_keys"`
+	NewKeys []atlasdns.DNSSECKey `json:"new_keys"`
+}
@@ -0,0 +1,214 @@
+// Package webhooks receives and verifies Atlas DNS webhook deliveries.
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxSkew is how old a signed timestamp can be before a delivery is
+// rejected as a possible replay.
+const defaultMaxSkew = 5 * time.Minute
+
+// signatureHeader is the header Atlas DNS sends the HMAC signature in, of
+// the form "t=<unix>,v1=<hex-hmac-sha256>".
+const signatureHeader = "X-Atlas-Signature"
+
+var (
+	// ErrMissingSignature is returned when the request has no signature header.
+	ErrMissingSignature = errors.New("webhooks: missing signature header")
+	// ErrInvalidSignature is returned when the signature doesn't match.
+	ErrInvalidSignature = errors.New("webhooks: signature verification failed")
+	// ErrStaleTimestamp is returned when the signed timestamp is older than
+	// the configured max skew.
+	ErrStaleTimestamp = errors.New("webhooks: timestamp outside of allowed skew")
+)
+
+// Receiver is an http.Handler that verifies and dispatches Atlas DNS
+// webhook deliveries.
+type Receiver struct {
+	secret  []byte
+	maxSkew time.Duration
+	logger  *slog.Logger
+
+	mu       sync.RWMutex
+	handlers map[EventType]reflect.Value
+}
+
+// ReceiverOption configures a Receiver.
+type ReceiverOption func(*Receiver)
+
+// WithMaxSkew overrides the default 5-minute replay window.
+func WithMaxSkew(d time.Duration) ReceiverOption {
+	return func(r *Receiver) {
+		r.maxSkew = d
+	}
+}
+
+// WithLogger sets the logger used for handler and verification failures.
+func WithLogger(logger *slog.Logger) ReceiverOption {
+	return func(r *Receiver) {
+		r.logger = logger
+	}
+}
+
+// NewReceiver returns an http.Handler that verifies the X-Atlas-Signature
+// header against secret and dispatches each event to a handler registered
+// with On.
+func NewReceiver(secret string, opts ...ReceiverOption) *Receiver {
+	r := &Receiver{
+		secret:   []byte(secret),
+		maxSkew:  defaultMaxSkew,
+		logger:   slog.Default(),
+		handlers: make(map[EventType]reflect.Value),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// On registers handler for eventType. handler must have the signature
+// func(ctx context.Context, ev T) error, where T is the event-specific
+// payload type (e.g. HealthCheckFailedEvent for EventHealthCheckFailed).
+// On panics if handler doesn't match that shape, since a mismatch is a
+// programming error the caller should catch at startup, not at delivery
+// time.
+func (r *Receiver) On(eventType EventType, handler interface{}) {
+	v := reflect.ValueOf(handler)
+	t := v.Type()
+
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 1 {
+		panic(fmt.Sprintf("webhooks: handler for %q must be func(context.Context, T) error", eventType))
+	}
+	if !t.In(0).Implements(reflect.TypeOf((*context.Context)(nil)).Elem()) {
+		panic(fmt.Sprintf("webhooks: handler for %q must take a context.Context as its first argument", eventType))
+	}
+	if !t.Out(0).Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		panic(fmt.Sprintf("webhooks: handler for %q must return error", eventType))
+	}
+
+	r.mu.Lock()
+	r.handlers[eventType] = v
+	r.mu.Unlock()
+}
+
+// ServeHTTP implements http.Handler. It verifies the signature, parses the
+// event envelope, and dispatches to the registered handler. A signature
+// failure results in 401; a body that can't be read or isn't a valid event
+// envelope results in 400; a handler error (or a Data payload that doesn't
+// decode into the handler's type) results in 500 so Atlas DNS retries the
+// delivery.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.verify(req.Header.Get(signatureHeader), body); err != nil {
+		r.logger.WarnContext(req.Context(), "webhooks: signature verification failed", "error", err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "malformed event payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.dispatch(req.Context(), event); err != nil {
+		r.logger.ErrorContext(req.Context(), "webhooks: handler failed", "event_type", event.Type, "error", err)
+		http.Error(w, "handler failed", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// verify checks header against body using constant-time comparison and
+// rejects timestamps older than r.maxSkew.
+func (r *Receiver) verify(header string, body []byte) error {
+	if header == "" {
+		return ErrMissingSignature
+	}
+
+	var timestamp int64
+	var signature string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			ts, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("%w: invalid timestamp", ErrInvalidSignature)
+			}
+			timestamp = ts
+		case "v1":
+			signature = kv[1]
+		}
+	}
+	if timestamp == 0 || signature == "" {
+		return fmt.Errorf("%w: malformed header", ErrInvalidSignature)
+	}
+
+	if age := time.Since(time.Unix(timestamp, 0)); age > r.maxSkew || age < -r.maxSkew {
+		return ErrStaleTimestamp
+	}
+
+	expected := sign(r.secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// dispatch decodes event.Data into the handler's declared payload type and
+// invokes it. Events with no registered handler are a no-op success, not
+// an error, since a receiver may only care about a subset of event types.
+func (r *Receiver) dispatch(ctx context.Context, event Event) error {
+	r.mu.RLock()
+	handler, ok := r.handlers[event.Type]
+	r.mu.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	payloadType := handler.Type().In(1)
+	payload := reflect.New(payloadType)
+	if err := json.Unmarshal(event.Data, payload.Interface()); err != nil {
+		return fmt.Errorf("webhooks: failed to decode %s payload: %w", event.Type, err)
+	}
+
+	results := handler.Call([]reflect.Value{reflect.ValueOf(ctx), payload.Elem()})
+	if errVal, _ := results[0].Interface().(error); errVal != nil {
+		return errVal
+	}
+	return nil
+}
+
+// sign computes the v1 signature for a timestamp+body pair: HMAC-SHA256
+// over "<timestamp>.<body>", hex-encoded.
+func sign(secret []byte, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
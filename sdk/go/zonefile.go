@@ -0,0 +1,208 @@
+package atlasdns
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// importChunkSize is the number of records sent per BulkCreateRecords call
+// while importing a zone file, keeping individual request bodies small.
+const importChunkSize = 500
+
+// ImportResult summarizes the outcome of an ImportZoneFile call.
+type ImportResult struct {
+	Created int
+	Skipped int
+	Errors  []error
+}
+
+// ImportZoneFile parses a BIND-format (RFC 1035) zone file from r and
+// uploads its records to zoneID via BulkCreateRecords, in chunks of
+// importChunkSize. Records of types the Atlas API does not support are
+// skipped and counted in ImportResult.Skipped rather than failing the
+// whole import.
+func (c *Client) ImportZoneFile(ctx context.Context, zoneID string, r io.Reader) (*ImportResult, error) {
+	result := &ImportResult{}
+
+	zp := dns.NewZoneParser(r, "", "")
+
+	var chunk []Record
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		created, err := c.BulkCreateRecords(ctx, zoneID, chunk)
+		result.Created += len(created)
+		chunk = chunk[:0]
+		return err
+	}
+
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		record, skip, err := rrToRecord(rr)
+		switch {
+		case skip:
+			result.Skipped++
+			continue
+		case err != nil:
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+
+		chunk = append(chunk, record)
+		if len(chunk) >= importChunkSize {
+			if err := flush(); err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("atlasdns: bulk create failed: %w", err))
+			}
+		}
+	}
+
+	if err := zp.Err(); err != nil {
+		result.Errors = append(result.Errors, fmt.Errorf("atlasdns: zone parse error: %w", err))
+	}
+
+	if err := flush(); err != nil {
+		result.Errors = append(result.Errors, fmt.Errorf("atlasdns: bulk create failed: %w", err))
+	}
+
+	return result, nil
+}
+
+// ExportZoneFile renders zoneID as a BIND-format zone file and writes it to
+// w: a synthesized $ORIGIN/$TTL header and apex SOA, followed by each record
+// from ListRecords rendered with dns.RR.String().
+func (c *Client) ExportZoneFile(ctx context.Context, zoneID string, w io.Writer) error {
+	zone, err := c.GetZone(ctx, zoneID)
+	if err != nil {
+		return fmt.Errorf("atlasdns: failed to get zone: %w", err)
+	}
+
+	origin := dns.Fqdn(zone.Name)
+	defaultTTL := uint32(3600)
+
+	fmt.Fprintf(w, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(w, "$TTL %d\n", defaultTTL)
+
+	soa, err := synthesizeSOA(origin, defaultTTL)
+	if err != nil {
+		return fmt.Errorf("atlasdns: failed to synthesize SOA: %w", err)
+	}
+	fmt.Fprintln(w, soa.String())
+
+	page := 1
+	const perPage = 500
+	for {
+		records, err := c.ListRecords(ctx, zoneID, &ListParams{Page: page, PerPage: perPage})
+		if err != nil {
+			return fmt.Errorf("atlasdns: failed to list records: %w", err)
+		}
+		if len(records) == 0 {
+			break
+		}
+
+		for _, record := range records {
+			rr, err := recordToRR(record, origin, defaultTTL)
+			if err != nil {
+				return fmt.Errorf("atlasdns: failed to render record %q: %w", record.Name, err)
+			}
+			fmt.Fprintln(w, rr.String())
+		}
+
+		if len(records) < perPage {
+			break
+		}
+		page++
+	}
+
+	return nil
+}
+
+// rrToRecord converts a parsed zone-file RR into an Atlas Record. skip is
+// true for RR types Atlas does not model (e.g. SOA, which Atlas manages
+// itself) so the caller can count them without treating them as errors.
+func rrToRecord(rr dns.RR) (record Record, skip bool, err error) {
+	hdr := rr.Header()
+	name := strings.TrimSuffix(hdr.Name, ".")
+	ttl := int(hdr.Ttl)
+
+	switch v := rr.(type) {
+	case *dns.A:
+		return Record{Name: name, Type: "A", Value: v.A.String(), TTL: ttl}, false, nil
+	case *dns.AAAA:
+		return Record{Name: name, Type: "AAAA", Value: v.AAAA.String(), TTL: ttl}, false, nil
+	case *dns.CNAME:
+		return Record{Name: name, Type: "CNAME", Value: strings.TrimSuffix(v.Target, "."), TTL: ttl}, false, nil
+	case *dns.NS:
+		return Record{Name: name, Type: "NS", Value: strings.TrimSuffix(v.Ns, "."), TTL: ttl}, false, nil
+	case *dns.TXT:
+		return Record{Name: name, Type: "TXT", Value: strings.Join(v.Txt, ""), TTL: ttl}, false, nil
+	case *dns.MX:
+		return Record{Name: name, Type: "MX", Value: strings.TrimSuffix(v.Mx, "."), Priority: int(v.Preference), TTL: ttl}, false, nil
+	case *dns.SRV:
+		return Record{
+			Name:     name,
+			Type:     "SRV",
+			Value:    strings.TrimSuffix(v.Target, "."),
+			Priority: int(v.Priority),
+			Weight:   int(v.Weight),
+			Port:     int(v.Port),
+			TTL:      ttl,
+		}, false, nil
+	case *dns.CAA:
+		return Record{Name: name, Type: "CAA", Value: v.Value, Tag: v.Tag, Flag: int(v.Flag), TTL: ttl}, false, nil
+	case *dns.SOA:
+		return Record{}, true, nil
+	default:
+		return Record{}, true, nil
+	}
+}
+
+// synthesizeSOA builds the apex SOA line an exported zone file needs but
+// ListRecords never returns, since Atlas manages the SOA server-side (see
+// rrToRecord's skip of *dns.SOA on import). The MNAME/RNAME and timers are
+// placeholders an operator importing into BIND/PowerDNS/Knot is expected to
+// adjust; the serial is the export time so repeated exports are monotonic.
+func synthesizeSOA(origin string, defaultTTL uint32) (dns.RR, error) {
+	line := fmt.Sprintf("%s\t%d\tIN\tSOA\tns1.%s hostmaster.%s %d 7200 3600 1209600 %d",
+		origin, defaultTTL, origin, origin, time.Now().Unix(), defaultTTL)
+	return dns.NewRR(line)
+}
+
+// recordToRR converts an Atlas Record back into a dns.RR suitable for
+// rendering with String(), defaulting to origin/defaultTTL when the record
+// doesn't specify its own.
+func recordToRR(record Record, origin string, defaultTTL uint32) (dns.RR, error) {
+	name := dns.Fqdn(record.Name)
+	if name == "." {
+		name = origin
+	}
+
+	ttl := defaultTTL
+	if record.TTL > 0 {
+		ttl = uint32(record.TTL)
+	}
+
+	var line string
+	switch record.Type {
+	case "A", "AAAA":
+		line = fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, ttl, record.Type, record.Value)
+	case "CNAME", "NS":
+		line = fmt.Sprintf("%s\t%d\tIN\t%s\t%s", name, ttl, record.Type, dns.Fqdn(record.Value))
+	case "TXT":
+		line = fmt.Sprintf("%s\t%d\tIN\tTXT\t%q", name, ttl, record.Value)
+	case "MX":
+		line = fmt.Sprintf("%s\t%d\tIN\tMX\t%d\t%s", name, ttl, record.Priority, dns.Fqdn(record.Value))
+	case "SRV":
+		line = fmt.Sprintf("%s\t%d\tIN\tSRV\t%d\t%d\t%d\t%s", name, ttl, record.Priority, record.Weight, record.Port, dns.Fqdn(record.Value))
+	case "CAA":
+		line = fmt.Sprintf("%s\t%d\tIN\tCAA\t%d\t%s\t%q", name, ttl, record.Flag, record.Tag, record.Value)
+	default:
+		return nil, fmt.Errorf("unsupported record type %q", record.Type)
+	}
+
+	return dns.NewRR(line)
+}
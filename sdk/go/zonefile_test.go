@@ -0,0 +1,36 @@
+package atlasdns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+// TestRecordRoundTripA catches the bug where recordToRR applied dns.Fqdn to
+// an A record's value, turning "192.0.2.1" into the invalid "192.0.2.1.".
+func TestRecordRoundTripA(t *testing.T) {
+	zp := dns.NewZoneParser(strings.NewReader("host.example.com. 3600 IN A 192.0.2.1\n"), "", "")
+	rr, ok := zp.Next()
+	if !ok {
+		t.Fatalf("failed to parse zone file: %v", zp.Err())
+	}
+
+	record, skip, err := rrToRecord(rr)
+	if skip || err != nil {
+		t.Fatalf("rrToRecord(%v) = skip=%v, err=%v", rr, skip, err)
+	}
+
+	out, err := recordToRR(record, "example.com.", 3600)
+	if err != nil {
+		t.Fatalf("recordToRR(%+v) error: %v", record, err)
+	}
+
+	a, ok := out.(*dns.A)
+	if !ok {
+		t.Fatalf("recordToRR(%+v) = %T, want *dns.A", record, out)
+	}
+	if got := a.A.String(); got != "192.0.2.1" {
+		t.Errorf("recordToRR(%+v).A = %q, want %q", record, got, "192.0.2.1")
+	}
+}